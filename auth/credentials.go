@@ -0,0 +1,80 @@
+// Package auth persists GitHub credentials obtained via the device
+// authorization flow so subsequent commands can reuse them without
+// re-exporting an environment variable.
+package auth
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Credentials is the on-disk representation written by the login
+// command and read back transparently by the root command's viper
+// config.
+type Credentials struct {
+	Token string `json:"token"`
+}
+
+// credentialsPath returns os.UserConfigDir()/my-cli/credentials.json.
+func credentialsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "my-cli", "credentials.json"), nil
+}
+
+// SaveCredentials persists token to disk with permissions restricted
+// to the current user.
+func SaveCredentials(token string) error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(Credentials{Token: token})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// LoadCredentials reads back the credentials saved by SaveCredentials.
+// It returns an error if none have been saved yet.
+func LoadCredentials() (*Credentials, error) {
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+// DeleteCredentials removes any credentials saved by SaveCredentials.
+// It is not an error if none exist.
+func DeleteCredentials() error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSaveLoadDeleteCredentials(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if err := SaveCredentials("gho_test123"); err != nil {
+		t.Fatalf("SaveCredentials() error = %v", err)
+	}
+
+	creds, err := LoadCredentials()
+	if err != nil {
+		t.Fatalf("LoadCredentials() error = %v", err)
+	}
+	if creds.Token != "gho_test123" {
+		t.Errorf("Token = %q, want %q", creds.Token, "gho_test123")
+	}
+
+	path, err := credentialsPath()
+	if err != nil {
+		t.Fatalf("credentialsPath() error = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat credentials file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("credentials file mode = %o, want %o", perm, 0o600)
+	}
+
+	if err := DeleteCredentials(); err != nil {
+		t.Fatalf("DeleteCredentials() error = %v", err)
+	}
+	if _, err := LoadCredentials(); err == nil {
+		t.Error("LoadCredentials() after delete error = nil, want error")
+	}
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// deviceCodeURL and accessTokenURL are vars, not consts, so tests can
+// point them at an httptest server.
+var (
+	deviceCodeURL  = "https://github.com/login/device/code"
+	accessTokenURL = "https://github.com/login/oauth/access_token"
+)
+
+const grantTypeDevice = "urn:ietf:params:oauth:grant-type:device_code"
+
+// DeviceCode is the response to the initial device authorization
+// request: a code for us to poll with and a code for the user to type
+// in their browser.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+var (
+	errAuthorizationPending = errors.New("authorization_pending")
+	errSlowDown             = errors.New("slow_down")
+	errExpiredToken         = errors.New("device code expired, run login again")
+	errAccessDenied         = errors.New("authorization denied")
+)
+
+// RequestDeviceCode starts GitHub's OAuth device authorization flow
+// for clientID, requesting the given scopes.
+func RequestDeviceCode(clientID string, scopes []string) (*DeviceCode, error) {
+	form := url.Values{
+		"client_id": {clientID},
+		"scope":     {strings.Join(scopes, " ")},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, deviceCodeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("auth: building device code request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("auth: requesting device code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("auth: device code request failed with status %d", resp.StatusCode)
+	}
+
+	var code DeviceCode
+	if err := json.NewDecoder(resp.Body).Decode(&code); err != nil {
+		return nil, fmt.Errorf("auth: decoding device code response: %w", err)
+	}
+
+	return &code, nil
+}
+
+// PollForAccessToken polls the access token endpoint at the interval
+// GitHub asked for until the user finishes authorizing in their
+// browser, the device code expires, or they deny access.
+func PollForAccessToken(clientID, deviceCode string, interval int) (string, error) {
+	if interval <= 0 {
+		interval = 5
+	}
+
+	for {
+		time.Sleep(time.Duration(interval) * time.Second)
+
+		token, err := requestAccessToken(clientID, deviceCode)
+		switch {
+		case err == nil:
+			return token, nil
+		case errors.Is(err, errAuthorizationPending):
+			continue
+		case errors.Is(err, errSlowDown):
+			interval += 5
+			continue
+		default:
+			return "", err
+		}
+	}
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func requestAccessToken(clientID, deviceCode string) (string, error) {
+	form := url.Values{
+		"client_id":   {clientID},
+		"device_code": {deviceCode},
+		"grant_type":  {grantTypeDevice},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, accessTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("auth: building access token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("auth: requesting access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("auth: decoding access token response: %w", err)
+	}
+
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+
+	switch body.Error {
+	case "authorization_pending":
+		return "", errAuthorizationPending
+	case "slow_down":
+		return "", errSlowDown
+	case "expired_token":
+		return "", errExpiredToken
+	case "access_denied":
+		return "", errAccessDenied
+	default:
+		return "", fmt.Errorf("auth: %s: %s", body.Error, body.ErrorDescription)
+	}
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func withDeviceFlowServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	prevDeviceCodeURL, prevAccessTokenURL := deviceCodeURL, accessTokenURL
+	deviceCodeURL = server.URL + "/device/code"
+	accessTokenURL = server.URL + "/oauth/access_token"
+	t.Cleanup(func() {
+		deviceCodeURL, accessTokenURL = prevDeviceCodeURL, prevAccessTokenURL
+	})
+}
+
+func TestRequestDeviceCode(t *testing.T) {
+	withDeviceFlowServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"device_code": "dc", "user_code": "ABCD-1234", "verification_uri": "https://github.com/login/device", "expires_in": 900, "interval": 5}`))
+	})
+
+	code, err := RequestDeviceCode("client-id", []string{"repo"})
+	if err != nil {
+		t.Fatalf("RequestDeviceCode() error = %v", err)
+	}
+	if code.UserCode != "ABCD-1234" || code.DeviceCode != "dc" {
+		t.Errorf("RequestDeviceCode() = %+v, want UserCode=ABCD-1234 DeviceCode=dc", code)
+	}
+}
+
+func TestPollForAccessTokenRetriesOnPending(t *testing.T) {
+	attempts := 0
+	withDeviceFlowServer(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Write([]byte(`{"error": "authorization_pending"}`))
+			return
+		}
+		w.Write([]byte(`{"access_token": "the-token"}`))
+	})
+
+	token, err := PollForAccessToken("client-id", "dc", 1)
+	if err != nil {
+		t.Fatalf("PollForAccessToken() error = %v", err)
+	}
+	if token != "the-token" {
+		t.Errorf("PollForAccessToken() = %q, want %q", token, "the-token")
+	}
+	if attempts != 3 {
+		t.Errorf("requestAccessToken called %d times, want 3", attempts)
+	}
+}
+
+func TestPollForAccessTokenPropagatesTerminalError(t *testing.T) {
+	withDeviceFlowServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error": "access_denied"}`))
+	})
+
+	if _, err := PollForAccessToken("client-id", "dc", 1); err != errAccessDenied {
+		t.Errorf("PollForAccessToken() error = %v, want %v", err, errAccessDenied)
+	}
+}
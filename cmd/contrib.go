@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"my-cli/contrib"
+	"my-cli/github"
+	"my-cli/output"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var contribCmd = &cobra.Command{
+	Use:   "contrib",
+	Short: "Mapeia emails de commit para logins do GitHub",
+}
+
+var (
+	contribMapRepo string
+	contribMapPath string
+)
+
+var contribMapCmd = &cobra.Command{
+	Use:   "map",
+	Short: "Percorre o histórico git local e resolve emails de autor em logins do GitHub",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if contribMapRepo == "" {
+			return fmt.Errorf("é necessário informar o repositório com --repo owner/name")
+		}
+		owner, repo, err := splitOwnerRepo(contribMapRepo)
+		if err != nil {
+			return err
+		}
+
+		service := github.NewService(github.NewClient(viper.GetString("token"), viper.GetInt("retries")))
+
+		identities, err := contrib.Map(contribMapPath, owner, repo, service)
+		if err != nil {
+			return err
+		}
+
+		resolved := 0
+		for _, login := range identities {
+			if login != "" {
+				resolved++
+			}
+		}
+		fmt.Printf("%d identidades mapeadas\n", resolved)
+		return nil
+	},
+}
+
+var contribLookupCmd = &cobra.Command{
+	Use:   "lookup <email>",
+	Short: "Consulta o login do GitHub associado a um email",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		login, ok, err := contrib.Lookup(args[0])
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("nenhum login mapeado para %q", args[0])
+		}
+
+		fmt.Println(login)
+		return nil
+	},
+}
+
+// identitiesResult is the typed result of the contrib export command,
+// rendered by output.Render in whichever format --output selects.
+type identitiesResult []contrib.Identity
+
+// Text renders identitiesResult for the "text" output format.
+func (r identitiesResult) Text() string {
+	headers, rows := r.CSVTable()
+	return output.Table(headers, rows)
+}
+
+// CSVTable renders identitiesResult for the "csv" output format.
+func (r identitiesResult) CSVTable() (headers []string, rows [][]string) {
+	headers = []string{"Email", "Login"}
+	rows = make([][]string, len(r))
+	for i, id := range r {
+		rows[i] = []string{id.Email, id.Login}
+	}
+	return headers, rows
+}
+
+var contribExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exporta o mapa de identidades",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		identities, err := contrib.Export()
+		if err != nil {
+			return err
+		}
+
+		return output.Render(os.Stdout, viper.GetString("output"), identitiesResult(identities))
+	},
+}
+
+func init() {
+	contribMapCmd.Flags().StringVar(&contribMapRepo, "repo", "", "Repositório GitHub owner/name usado para resolver os logins")
+	contribMapCmd.Flags().StringVar(&contribMapPath, "path", ".", "Caminho do repositório git local a percorrer")
+
+	contribCmd.AddCommand(contribMapCmd, contribLookupCmd, contribExportCmd)
+	rootCmd.AddCommand(contribCmd)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+
+	"my-cli/forge"
+	_ "my-cli/forge/gitea"
+	_ "my-cli/forge/github"
+	_ "my-cli/forge/gitlab"
+
+	"github.com/spf13/viper"
+)
+
+// forgeInstance is one entry of the `forges:` config section, letting
+// users declare named instances beyond the implicit default for
+// --forge=<type>, e.g.:
+//
+//	forges:
+//	  work-gitea:
+//	    type: gitea
+//	    base_url: https://git.mycompany.com
+//	    token: ...
+type forgeInstance struct {
+	Type    string `mapstructure:"type"`
+	BaseURL string `mapstructure:"base_url"`
+	Token   string `mapstructure:"token"`
+}
+
+// resolveForge builds the forge.Provider for name: a named instance
+// under the `forges:` config section if one exists, or name itself as
+// a backend type otherwise, falling back to the --token flag /
+// GITHUB_TOKEN-style config for the token when the instance doesn't
+// declare its own.
+func resolveForge(name string) (forge.Provider, error) {
+	var instances map[string]forgeInstance
+	if err := viper.UnmarshalKey("forges", &instances); err != nil {
+		return nil, fmt.Errorf("lendo a seção forges do config: %w", err)
+	}
+
+	cfg := forge.Config{Type: name}
+	if inst, ok := instances[name]; ok {
+		cfg = forge.Config{Type: inst.Type, BaseURL: inst.BaseURL, Token: inst.Token}
+	}
+	if cfg.Token == "" {
+		cfg.Token = viper.GetString("token")
+	}
+	cfg.Retries = viper.GetInt("retries")
+
+	provider, err := forge.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("forge %q: %w", name, err)
+	}
+	return provider, nil
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"my-cli/github"
+	"my-cli/output"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// gistsResult is the typed result of the gists command, rendered by
+// output.Render in whichever format --output selects.
+type gistsResult []github.Gist
+
+// Text renders gistsResult for the "text" output format.
+func (g gistsResult) Text() string {
+	headers, rows := g.CSVTable()
+	return output.Table(headers, rows)
+}
+
+// CSVTable renders gistsResult for the "csv" output format.
+func (g gistsResult) CSVTable() (headers []string, rows [][]string) {
+	headers = []string{"ID", "Público", "Descrição"}
+	rows = make([][]string, len(g))
+	for i, gist := range g {
+		rows[i] = []string{gist.ID, strconv.FormatBool(gist.Public), gist.Description}
+	}
+	return headers, rows
+}
+
+var gistsCmd = &cobra.Command{
+	Use:   "gists <user>",
+	Short: "Lista os gists públicos de um usuário",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		service := github.NewService(github.NewClient(viper.GetString("token"), viper.GetInt("retries")))
+
+		gists, err := service.ListGists(args[0])
+		if err != nil {
+			return err
+		}
+
+		return output.Render(os.Stdout, viper.GetString("output"), gistsResult(gists))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(gistsCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"my-cli/auth"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// gitCredentialUsername is sent as the username for GitHub token auth.
+// GitHub ignores the username when a token is supplied as the
+// password, but git's credential protocol requires one.
+const gitCredentialUsername = "x-access-token"
+
+var gitCredentialCmd = &cobra.Command{
+	Use:   "git-credential <operation>",
+	Short: "Implementa o protocolo de credential helper do git",
+	Long: `Implementa o protocolo de credential helper do git, reutilizando o
+token salvo por "my-cli login" para autenticar operações git contra o
+GitHub. Para instalar, configure:
+
+  git config --global credential.https://github.com.helper "!my-cli git-credential"
+`,
+	Hidden: true,
+	Args:   cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input, err := parseCredentialInput(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("git-credential: lendo entrada: %w", err)
+		}
+
+		// `store` and `erase` have nothing to do: `my-cli login`/`logout`
+		// already own the credential's lifecycle.
+		if args[0] != "get" {
+			return nil
+		}
+
+		if input["host"] != "github.com" {
+			return nil
+		}
+
+		creds, err := auth.LoadCredentials()
+		if err != nil {
+			return fmt.Errorf("git-credential: nenhuma credencial salva, execute 'my-cli login'")
+		}
+
+		fmt.Printf("username=%s\npassword=%s\n", gitCredentialUsername, creds.Token)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(gitCredentialCmd)
+}
+
+// parseCredentialInput reads key=value lines from r until a blank line
+// or EOF, per git's credential helper protocol.
+func parseCredentialInput(r io.Reader) (map[string]string, error) {
+	values := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		values[key] = value
+	}
+
+	return values, scanner.Err()
+}
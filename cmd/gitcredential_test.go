@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCredentialInput(t *testing.T) {
+	input := "protocol=https\nhost=github.com\npath=chmenegatti/my-cli\n\nignored after blank line\n"
+
+	values, err := parseCredentialInput(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseCredentialInput() error = %v", err)
+	}
+
+	want := map[string]string{
+		"protocol": "https",
+		"host":     "github.com",
+		"path":     "chmenegatti/my-cli",
+	}
+	for key, wantValue := range want {
+		if values[key] != wantValue {
+			t.Errorf("values[%q] = %q, want %q", key, values[key], wantValue)
+		}
+	}
+	if len(values) != len(want) {
+		t.Errorf("values = %v, want exactly %v", values, want)
+	}
+}
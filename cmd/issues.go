@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"my-cli/forge"
+	"my-cli/output"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	issuesSince string
+	issuesUntil string
+)
+
+// issuesResult is the typed result of the issues command, rendered by
+// output.Render in whichever format --output selects.
+type issuesResult []forge.Issue
+
+// Text renders issuesResult for the "text" output format.
+func (r issuesResult) Text() string {
+	headers, rows := r.CSVTable()
+	return output.Table(headers, rows)
+}
+
+// CSVTable renders issuesResult for the "csv" output format.
+func (r issuesResult) CSVTable() (headers []string, rows [][]string) {
+	headers = []string{"Número", "Título", "Estado", "Autor"}
+	rows = make([][]string, len(r))
+	for i, issue := range r {
+		rows[i] = []string{strconv.Itoa(issue.Number), issue.Title, issue.State, issue.Author}
+	}
+	return headers, rows
+}
+
+var issuesCmd = &cobra.Command{
+	Use:   "issues <owner>/<repo>",
+	Short: "Lista as issues de um repositório",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		owner, repo, err := splitOwnerRepo(args[0])
+		if err != nil {
+			return err
+		}
+
+		since, until, err := parseSinceUntil(issuesSince, issuesUntil)
+		if err != nil {
+			return err
+		}
+
+		provider, err := resolveForge(viper.GetString("forge"))
+		if err != nil {
+			return err
+		}
+
+		issues, err := provider.ListIssues(context.Background(), owner, repo)
+		if err != nil {
+			return err
+		}
+		issues = filterIssuesByCreatedAt(issues, since, until)
+
+		return output.Render(os.Stdout, viper.GetString("output"), issuesResult(issues))
+	},
+}
+
+func init() {
+	issuesCmd.Flags().StringVar(&issuesSince, "since", "", "Apenas issues criadas a partir desta data (RFC3339)")
+	issuesCmd.Flags().StringVar(&issuesUntil, "until", "", "Apenas issues criadas até esta data (RFC3339)")
+	rootCmd.AddCommand(issuesCmd)
+}
+
+func splitOwnerRepo(spec string) (owner, repo string, err error) {
+	parts := strings.SplitN(spec, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("repositório inválido %q, use o formato owner/repo", spec)
+	}
+	return parts[0], parts[1], nil
+}
+
+func parseSinceUntil(since, until string) (time.Time, time.Time, error) {
+	var sinceTime, untilTime time.Time
+	var err error
+
+	if since != "" {
+		sinceTime, err = time.Parse(time.RFC3339, since)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("--since inválido: %w", err)
+		}
+	}
+	if until != "" {
+		untilTime, err = time.Parse(time.RFC3339, until)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("--until inválido: %w", err)
+		}
+	}
+
+	return sinceTime, untilTime, nil
+}
+
+func filterIssuesByCreatedAt(issues []forge.Issue, since, until time.Time) []forge.Issue {
+	if since.IsZero() && until.IsZero() {
+		return issues
+	}
+
+	filtered := make([]forge.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if !since.IsZero() && issue.CreatedAt.Before(since) {
+			continue
+		}
+		if !until.IsZero() && issue.CreatedAt.After(until) {
+			continue
+		}
+		filtered = append(filtered, issue)
+	}
+	return filtered
+}
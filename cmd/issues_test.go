@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"my-cli/forge"
+)
+
+func TestSplitOwnerRepo(t *testing.T) {
+	owner, repo, err := splitOwnerRepo("chmenegatti/my-cli")
+	if err != nil {
+		t.Fatalf("splitOwnerRepo() error = %v", err)
+	}
+	if owner != "chmenegatti" || repo != "my-cli" {
+		t.Errorf("splitOwnerRepo() = (%q, %q), want (%q, %q)", owner, repo, "chmenegatti", "my-cli")
+	}
+
+	if _, _, err := splitOwnerRepo("invalid"); err == nil {
+		t.Error("splitOwnerRepo(\"invalid\") error = nil, want error")
+	}
+}
+
+func TestFilterIssuesByCreatedAt(t *testing.T) {
+	mustParse := func(s string) time.Time {
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatalf("time.Parse(%q) error = %v", s, err)
+		}
+		return ts
+	}
+
+	issues := []forge.Issue{
+		{Number: 1, CreatedAt: mustParse("2024-01-01T00:00:00Z")},
+		{Number: 2, CreatedAt: mustParse("2024-06-01T00:00:00Z")},
+		{Number: 3, CreatedAt: mustParse("2024-12-01T00:00:00Z")},
+	}
+	since, _ := time.Parse(time.RFC3339, "2024-03-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2024-09-01T00:00:00Z")
+
+	filtered := filterIssuesByCreatedAt(issues, since, until)
+	if len(filtered) != 1 || filtered[0].Number != 2 {
+		t.Errorf("filterIssuesByCreatedAt() = %+v, want only issue #2", filtered)
+	}
+}
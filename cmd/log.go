@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/spf13/viper"
+)
+
+// logger is the CLI's leveled logger. It always writes to stderr so
+// diagnostics never mix with command results on stdout, which may be
+// piped into other tools.
+var logger = slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+// initLogger reconfigures logger's level from the --log-level flag,
+// once viper has had a chance to bind it. Falls back to info on an
+// invalid level.
+func initLogger() {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(viper.GetString("log-level"))); err != nil {
+		level = slog.LevelInfo
+	}
+	logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
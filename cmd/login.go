@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"my-cli/auth"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// loginScopes are the OAuth scopes requested during device login,
+// covering everything the repos/gists/issues/stats commands need.
+var loginScopes = []string{"repo", "read:org", "gist"}
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Autentica com o GitHub via OAuth device flow",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		clientID := viper.GetString("client_id")
+		if clientID == "" {
+			return fmt.Errorf("login: nenhum client_id configurado; defina --client-id, a variável GITHUB_CLIENT_ID ou client_id em ~/.my-cli.yaml")
+		}
+
+		code, err := auth.RequestDeviceCode(clientID, loginScopes)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Acesse %s e informe o código: %s\n", code.VerificationURI, code.UserCode)
+		if err := openBrowser(code.VerificationURI); err != nil {
+			fmt.Println("Não foi possível abrir o navegador automaticamente, acesse o link acima manualmente.")
+		}
+
+		token, err := auth.PollForAccessToken(clientID, code.DeviceCode, code.Interval)
+		if err != nil {
+			return err
+		}
+
+		if err := auth.SaveCredentials(token); err != nil {
+			return fmt.Errorf("login: salvando credenciais: %w", err)
+		}
+
+		fmt.Println("Login realizado com sucesso!")
+		return nil
+	},
+}
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove as credenciais do GitHub salvas localmente",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := auth.DeleteCredentials(); err != nil {
+			return fmt.Errorf("logout: removendo credenciais: %w", err)
+		}
+		fmt.Println("Logout realizado com sucesso!")
+		return nil
+	},
+}
+
+func init() {
+	loginCmd.Flags().String("client-id", "", "Client ID do OAuth App do GitHub usado para o device flow")
+	_ = viper.BindPFlag("client_id", loginCmd.Flags().Lookup("client-id"))
+	_ = viper.BindEnv("client_id", "GITHUB_CLIENT_ID")
+
+	rootCmd.AddCommand(loginCmd)
+	rootCmd.AddCommand(logoutCmd)
+}
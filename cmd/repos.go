@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"my-cli/forge"
+	"my-cli/output"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// reposResult is the typed result of the repos command, rendered by
+// output.Render in whichever format --output selects.
+type reposResult []forge.Repo
+
+// Text renders reposResult for the "text" output format.
+func (r reposResult) Text() string {
+	headers, rows := r.CSVTable()
+	return output.Table(headers, rows)
+}
+
+// CSVTable renders reposResult for the "csv" output format.
+func (r reposResult) CSVTable() (headers []string, rows [][]string) {
+	headers = []string{"Nome", "Estrelas", "Descrição"}
+	rows = make([][]string, len(r))
+	for i, repo := range r {
+		rows[i] = []string{repo.FullName, strconv.Itoa(repo.Stars), repo.Description}
+	}
+	return headers, rows
+}
+
+var reposCmd = &cobra.Command{
+	Use:   "repos <user>",
+	Short: "Lista os repositórios públicos de um usuário",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider, err := resolveForge(viper.GetString("forge"))
+		if err != nil {
+			return err
+		}
+
+		repos, err := provider.ListRepos(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+
+		return output.Render(os.Stdout, viper.GetString("output"), reposResult(repos))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reposCmd)
+}
@@ -1,39 +1,111 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"my-cli/auth"
 	"my-cli/github"
+	"my-cli/output"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// userResult is the typed result of the root command's user lookup,
+// rendered by output.Render in whichever format --output selects.
+type userResult struct {
+	Login              string `json:"login" yaml:"login"`
+	Name               string `json:"name" yaml:"name"`
+	Followers          int    `json:"followers" yaml:"followers"`
+	Following          int    `json:"following" yaml:"following"`
+	RateLimitRemaining *int   `json:"rate_limit_remaining,omitempty" yaml:"rate_limit_remaining,omitempty"`
+}
+
+// Text renders userResult for the "text" output format.
+func (u userResult) Text() string {
+	s := fmt.Sprintf("Usuário: %s\nNome: %s\nSeguidores: %d\nSeguindo: %d", u.Login, u.Name, u.Followers, u.Following)
+	if u.RateLimitRemaining != nil {
+		s += fmt.Sprintf("\nRequisições restantes: %d", *u.RateLimitRemaining)
+	}
+	return s
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "my-cli",
 	Short: "Uma aplicação CLI para buscar usuários no GitHub",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		user, _ := cmd.Flags().GetString("user")
 		if user == "" {
-			fmt.Println("É necessário informar um usuário com -u ou --user")
-			os.Exit(1)
+			return fmt.Errorf("é necessário informar um usuário com -u ou --user")
+		}
+
+		provider, err := resolveForge(viper.GetString("forge"))
+		if err != nil {
+			return err
+		}
+
+		forgeUser, err := provider.GetUser(context.Background(), user)
+		if err != nil {
+			return err
 		}
-		github.GetUser(user)
+
+		result := userResult{
+			Login:     forgeUser.Login,
+			Name:      forgeUser.Name,
+			Followers: forgeUser.Followers,
+			Following: forgeUser.Following,
+		}
+		if remaining, err := provider.RateLimit(context.Background()); err == nil && remaining >= 0 {
+			result.RateLimitRemaining = &remaining
+		}
+
+		return output.Render(os.Stdout, viper.GetString("output"), result)
 	},
 }
 
 func Execute() {
+	rootCmd.SilenceErrors = true
+	rootCmd.SilenceUsage = true
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		logger.Error(err.Error())
 		os.Exit(1)
 	}
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	cobra.OnInitialize(initConfig, initLogger)
 	rootCmd.PersistentFlags().StringP("user", "u", "", "Usuário do GitHub")
+	rootCmd.PersistentFlags().StringP("token", "t", "", "Token de acesso pessoal do GitHub")
+	rootCmd.PersistentFlags().String("forge", "github", "Forge a usar: um tipo (github, gitea, gitlab) ou uma instância declarada em forges:")
+	rootCmd.PersistentFlags().StringP("output", "o", "text", "Formato de saída: text, json, yaml ou csv")
+	rootCmd.PersistentFlags().String("log-level", "info", "Nível de log: debug, info, warn ou error")
+	rootCmd.PersistentFlags().Int("retries", github.DefaultMaxRetries, "Número máximo de tentativas em erros 5xx, rede ou rate limit")
+	_ = viper.BindPFlag("token", rootCmd.PersistentFlags().Lookup("token"))
+	_ = viper.BindPFlag("forge", rootCmd.PersistentFlags().Lookup("forge"))
+	_ = viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
+	_ = viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
+	_ = viper.BindPFlag("retries", rootCmd.PersistentFlags().Lookup("retries"))
 }
 
 func initConfig() {
+	home, err := os.UserHomeDir()
+	if err == nil {
+		viper.AddConfigPath(home)
+		viper.SetConfigName(".my-cli")
+		viper.SetConfigType("yaml")
+	}
+
+	_ = viper.BindEnv("token", "GITHUB_TOKEN")
 	viper.AutomaticEnv()
+
+	_ = viper.ReadInConfig()
+
+	// Fall back to the token saved by `my-cli login`, if any, so
+	// authenticated commands work without an explicit flag, env var or
+	// config entry. SetDefault has the lowest precedence in viper, so
+	// an explicit --token/GITHUB_TOKEN/config value always wins.
+	if creds, err := auth.LoadCredentials(); err == nil && creds.Token != "" {
+		viper.SetDefault("token", creds.Token)
+	}
 }
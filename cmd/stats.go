@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"my-cli/forge"
+	"my-cli/output"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	statsSince string
+	statsUntil string
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats <org>",
+	Short: "Mostra estatísticas de issues de uma organização",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		since, until, err := parseSinceUntil(statsSince, statsUntil)
+		if err != nil {
+			return err
+		}
+
+		provider, err := resolveForge(viper.GetString("forge"))
+		if err != nil {
+			return err
+		}
+
+		stats, err := forge.GetStats(context.Background(), provider, args[0], since, until)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(os.Stdout, viper.GetString("output"), stats)
+	},
+}
+
+func init() {
+	statsCmd.Flags().StringVar(&statsSince, "since", "", "Considera apenas issues criadas a partir desta data (RFC3339)")
+	statsCmd.Flags().StringVar(&statsUntil, "until", "", "Considera apenas issues criadas até esta data (RFC3339)")
+	rootCmd.AddCommand(statsCmd)
+}
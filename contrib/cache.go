@@ -0,0 +1,74 @@
+package contrib
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// cachePath returns os.UserCacheDir()/my-cli/contrib.json.
+func cachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "my-cli", "contrib.json"), nil
+}
+
+// loadCache reads the email->login map persisted by saveCache, or an
+// empty map if none has been saved yet.
+func loadCache() (map[string]string, error) {
+	path, err := cachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	identities := map[string]string{}
+	if err := json.Unmarshal(data, &identities); err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+// saveCache persists the email->login map to disk.
+func saveCache(identities map[string]string) error {
+	path, err := cachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(identities, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Lookup returns the GitHub login cached for email, and whether one
+// was found. An email cached with an empty login, recorded by Map for
+// commit authors with no linked GitHub account, is reported as not
+// found.
+func Lookup(email string) (string, bool, error) {
+	identities, err := loadCache()
+	if err != nil {
+		return "", false, err
+	}
+	login, ok := identities[email]
+	if !ok || login == "" {
+		return "", false, nil
+	}
+	return login, true, nil
+}
@@ -0,0 +1,55 @@
+package contrib
+
+import "testing"
+
+func TestSaveLoadCacheAndLookup(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if err := saveCache(map[string]string{"octocat@example.com": "octocat"}); err != nil {
+		t.Fatalf("saveCache() error = %v", err)
+	}
+
+	login, ok, err := Lookup("octocat@example.com")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if !ok || login != "octocat" {
+		t.Errorf("Lookup() = (%q, %v), want (%q, true)", login, ok, "octocat")
+	}
+
+	if _, ok, err := Lookup("nobody@example.com"); err != nil || ok {
+		t.Errorf("Lookup(unknown) = (_, %v, %v), want (_, false, nil)", ok, err)
+	}
+}
+
+func TestLookupTreatsEmptyLoginAsNotFound(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if err := saveCache(map[string]string{"unlinked@example.com": ""}); err != nil {
+		t.Fatalf("saveCache() error = %v", err)
+	}
+
+	if login, ok, err := Lookup("unlinked@example.com"); err != nil || ok || login != "" {
+		t.Errorf("Lookup(unlinked) = (%q, %v, %v), want (\"\", false, nil)", login, ok, err)
+	}
+}
+
+func TestExportSortsByEmail(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if err := saveCache(map[string]string{
+		"bob@example.com":      "bob",
+		"alice@example.com":    "alice",
+		"unlinked@example.com": "",
+	}); err != nil {
+		t.Fatalf("saveCache() error = %v", err)
+	}
+
+	identities, err := Export()
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(identities) != 2 || identities[0].Email != "alice@example.com" || identities[1].Email != "bob@example.com" {
+		t.Errorf("Export() = %+v, want sorted by email, unresolved emails omitted", identities)
+	}
+}
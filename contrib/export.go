@@ -0,0 +1,29 @@
+package contrib
+
+import "sort"
+
+// Identity is one email->login pair, as reported by Export.
+type Identity struct {
+	Email string `json:"email" yaml:"email"`
+	Login string `json:"login" yaml:"login"`
+}
+
+// Export returns every cached identity with a resolved GitHub login,
+// sorted by email. Emails cached with an empty login, recorded by Map
+// for commit authors with no linked GitHub account, are omitted.
+func Export() ([]Identity, error) {
+	identities, err := loadCache()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Identity, 0, len(identities))
+	for email, login := range identities {
+		if login == "" {
+			continue
+		}
+		out = append(out, Identity{Email: email, Login: login})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Email < out[j].Email })
+	return out, nil
+}
@@ -0,0 +1,66 @@
+// Package contrib maintains an email-to-GitHub-login identity map for
+// a project's contributors, built by walking local git history and
+// resolving each commit's author through the GitHub commits API. It
+// mirrors the find-github-email mode of golang/build's gopherstats
+// tool, giving maintainers a reusable identity map for CLA checks,
+// changelog generation, and stat reports without re-scraping the API
+// every run.
+package contrib
+
+import (
+	"fmt"
+	"my-cli/github"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Map walks the git history at repoPath, collecting each commit's
+// author email, and for every email not already cached asks the
+// GitHub commits API for owner/repo to resolve the commit's SHA to a
+// GitHub login. Emails whose commit author isn't linked to a GitHub
+// account are cached too, as an empty login, so they aren't re-fetched
+// on every run. Identities are saved to the on-disk cache as they're
+// resolved, so a failure partway through the walk (a network blip, a
+// rate limit, a commit no longer present upstream) still keeps
+// whatever was resolved before it instead of discarding the run.
+func Map(repoPath, owner, repo string, service *github.Service) (map[string]string, error) {
+	repository, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("contrib: opening %s: %w", repoPath, err)
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		return nil, fmt.Errorf("contrib: resolving HEAD of %s: %w", repoPath, err)
+	}
+
+	commits, err := repository.Log(&git.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("contrib: walking history of %s: %w", repoPath, err)
+	}
+
+	identities, err := loadCache()
+	if err != nil {
+		return nil, err
+	}
+
+	walkErr := commits.ForEach(func(c *object.Commit) error {
+		email := c.Author.Email
+		if _, known := identities[email]; known {
+			return nil
+		}
+
+		commit, err := service.GetCommit(owner, repo, c.Hash.String())
+		if err != nil {
+			return fmt.Errorf("contrib: resolving %s: %w", c.Hash.String(), err)
+		}
+		identities[email] = commit.Author.Login
+
+		return saveCache(identities)
+	})
+	if walkErr != nil {
+		return identities, walkErr
+	}
+	return identities, nil
+}
@@ -0,0 +1,87 @@
+package contrib
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"my-cli/github"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// commit adds a file with the given content and commits it as author,
+// returning the new commit's SHA.
+func commit(t *testing.T, wt *git.Worktree, repoPath, name, content, authorEmail string) string {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile(%s) error = %v", name, err)
+	}
+	if _, err := wt.Add(name); err != nil {
+		t.Fatalf("Add(%s) error = %v", name, err)
+	}
+	hash, err := wt.Commit("add "+name, &git.CommitOptions{
+		Author: &object.Signature{Name: "Author", Email: authorEmail, When: time.Now()},
+	})
+	if err != nil {
+		t.Fatalf("Commit(%s) error = %v", name, err)
+	}
+	return hash.String()
+}
+
+func TestMapSavesProgressIncrementally(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	repoPath := t.TempDir()
+	repository, err := git.PlainInit(repoPath, false)
+	if err != nil {
+		t.Fatalf("PlainInit() error = %v", err)
+	}
+	wt, err := repository.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree() error = %v", err)
+	}
+
+	// commits.ForEach walks from HEAD backwards (newest first), so the
+	// failing commit is made first and the resolvable one last, to
+	// exercise the failing commit only after the good one is saved.
+	badSHA := commit(t, wt, repoPath, "a.txt", "a", "unresolved@example.com")
+	goodSHA := commit(t, wt, repoPath, "b.txt", "b", "resolved@example.com")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, goodSHA):
+			fmt.Fprint(w, `{"author": {"login": "octocat"}}`)
+		case strings.Contains(r.URL.Path, badSHA):
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := github.NewClient("", 1)
+	client.HTTPClient = server.Client()
+	client.BaseURL = server.URL
+	client.CacheDir = t.TempDir()
+	service := github.NewService(client)
+
+	_, err = Map(repoPath, "owner", "repo", service)
+	if err == nil {
+		t.Fatal("Map() error = nil, want an error from the failing commit lookup")
+	}
+
+	login, ok, err := Lookup("resolved@example.com")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if !ok || login != "octocat" {
+		t.Errorf("Lookup(resolved) = (%q, %v), want (\"octocat\", true) saved before the failure", login, ok)
+	}
+}
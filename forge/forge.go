@@ -0,0 +1,86 @@
+// Package forge abstracts over Git-forge backends (GitHub, Gitea,
+// GitLab, ...) behind a single Provider interface, so the cmd
+// subcommands can work against whichever one the user configures.
+// Concrete backends self-register under a name with Register, the way
+// git-bug's bridges register their own importers/exporters.
+package forge
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// User is a subset of a forge user profile, common across backends.
+type User struct {
+	Login     string
+	Name      string
+	Followers int
+	Following int
+}
+
+// Repo is a subset of a forge repository, common across backends.
+type Repo struct {
+	Name        string `json:"name" yaml:"name"`
+	FullName    string `json:"full_name" yaml:"full_name"`
+	Description string `json:"description" yaml:"description"`
+	Stars       int    `json:"stars" yaml:"stars"`
+	Private     bool   `json:"private" yaml:"private"`
+}
+
+// Issue is a subset of a forge issue, common across backends. A zero
+// ClosedAt means the issue is still open.
+type Issue struct {
+	Number    int       `json:"number" yaml:"number"`
+	Title     string    `json:"title" yaml:"title"`
+	State     string    `json:"state" yaml:"state"`
+	Author    string    `json:"author" yaml:"author"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+	ClosedAt  time.Time `json:"closed_at" yaml:"closed_at"`
+	Labels    []string  `json:"labels" yaml:"labels"`
+}
+
+// Provider is implemented by each forge backend (forge/github,
+// forge/gitea, forge/gitlab, ...).
+type Provider interface {
+	GetUser(ctx context.Context, login string) (*User, error)
+	ListRepos(ctx context.Context, owner string) ([]Repo, error)
+	ListIssues(ctx context.Context, owner, repo string) ([]Issue, error)
+	// RateLimit reports remaining API calls for the current window, or
+	// -1 if the backend doesn't expose one.
+	RateLimit(ctx context.Context) (int, error)
+}
+
+// Config is what a user declares for a forge instance, either the
+// implicit default instance for --forge=<type> or a named instance
+// under the `forges:` config section.
+type Config struct {
+	Type    string
+	BaseURL string
+	Token   string
+	// Retries bounds how many attempts, the first one included, a
+	// backend makes at a failed request, where it supports retries at
+	// all. Zero means the backend's own default.
+	Retries int
+}
+
+// Factory builds a Provider from a Config. Backends register one under
+// their type name via Register.
+type Factory func(cfg Config) (Provider, error)
+
+var factories = map[string]Factory{}
+
+// Register makes a Factory available under name for New to look up.
+// It is meant to be called from a backend package's init().
+func Register(name string, factory Factory) {
+	factories[name] = factory
+}
+
+// New builds the Provider registered under cfg.Type.
+func New(cfg Config) (Provider, error) {
+	factory, ok := factories[cfg.Type]
+	if !ok {
+		return nil, fmt.Errorf("forge: unknown provider type %q", cfg.Type)
+	}
+	return factory(cfg)
+}
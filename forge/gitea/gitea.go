@@ -0,0 +1,199 @@
+// Package gitea implements forge.Provider against the Gitea REST API
+// (https://docs.gitea.com/api/next).
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"my-cli/forge"
+)
+
+const defaultBaseURL = "https://gitea.com"
+
+// perPage is the page size requested on paged list endpoints.
+const perPage = 50
+
+func init() {
+	forge.Register("gitea", New)
+}
+
+// New builds a forge.Provider for a Gitea instance. cfg.BaseURL
+// defaults to gitea.com.
+func New(cfg forge.Config) (forge.Provider, error) {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	return &Provider{
+		httpClient: http.DefaultClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      cfg.Token,
+	}, nil
+}
+
+// Provider implements forge.Provider against a Gitea instance.
+type Provider struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+type giteaUser struct {
+	Login          string `json:"login"`
+	FullName       string `json:"full_name"`
+	FollowersCount int    `json:"followers_count"`
+	FollowingCount int    `json:"following_count"`
+}
+
+type giteaRepo struct {
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	StarsCount  int    `json:"stars_count"`
+	Private     bool   `json:"private"`
+}
+
+type giteaIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	User   struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	CreatedAt string `json:"created_at"`
+	ClosedAt  string `json:"closed_at"`
+	Labels    []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	// PullRequest is set by Gitea on entries from the issues endpoint
+	// that are actually pull requests.
+	PullRequest json.RawMessage `json:"pull_request,omitempty"`
+}
+
+func (p *Provider) GetUser(ctx context.Context, login string) (*forge.User, error) {
+	var user giteaUser
+	if err := p.get(ctx, fmt.Sprintf("/api/v1/users/%s", login), &user); err != nil {
+		return nil, err
+	}
+
+	return &forge.User{
+		Login:     user.Login,
+		Name:      user.FullName,
+		Followers: user.FollowersCount,
+		Following: user.FollowingCount,
+	}, nil
+}
+
+func (p *Provider) ListRepos(ctx context.Context, owner string) ([]forge.Repo, error) {
+	repos, err := getPaged[giteaRepo](ctx, p, fmt.Sprintf("/api/v1/users/%s/repos", owner))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]forge.Repo, len(repos))
+	for i, r := range repos {
+		out[i] = forge.Repo{Name: r.Name, FullName: r.FullName, Description: r.Description, Stars: r.StarsCount, Private: r.Private}
+	}
+	return out, nil
+}
+
+// ListIssues returns every issue on owner/repo. Gitea's issues
+// endpoint also returns pull requests (each carrying a non-empty
+// PullRequest field), which are filtered out here so they don't skew
+// issue counts and stats.
+func (p *Provider) ListIssues(ctx context.Context, owner, repo string) ([]forge.Issue, error) {
+	issues, err := getPaged[giteaIssue](ctx, p, fmt.Sprintf("/api/v1/repos/%s/%s/issues?state=all", owner, repo))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]forge.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if len(issue.PullRequest) != 0 {
+			continue
+		}
+
+		labels := make([]string, len(issue.Labels))
+		for j, l := range issue.Labels {
+			labels[j] = l.Name
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, issue.CreatedAt)
+		var closedAt time.Time
+		if issue.ClosedAt != "" {
+			closedAt, _ = time.Parse(time.RFC3339, issue.ClosedAt)
+		}
+
+		out = append(out, forge.Issue{
+			Number:    issue.Number,
+			Title:     issue.Title,
+			State:     issue.State,
+			Author:    issue.User.Login,
+			CreatedAt: createdAt,
+			ClosedAt:  closedAt,
+			Labels:    labels,
+		})
+	}
+	return out, nil
+}
+
+// RateLimit always returns -1: Gitea does not expose a rate limit API.
+func (p *Provider) RateLimit(ctx context.Context) (int, error) {
+	return -1, nil
+}
+
+func (p *Provider) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("gitea: building request: %w", err)
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "token "+p.token)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gitea: requesting %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("gitea: unexpected status %d for %s", resp.StatusCode, path)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("gitea: decoding response for %s: %w", path, err)
+	}
+	return nil
+}
+
+// getPaged walks path page by page, following Gitea's "?page=N"
+// convention until a page comes back empty or shorter than perPage,
+// and concatenates the results.
+func getPaged[T any](ctx context.Context, p *Provider, path string) ([]T, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	var all []T
+	for page := 1; ; page++ {
+		var items []T
+		if err := p.get(ctx, fmt.Sprintf("%s%spage=%d&limit=%d", path, sep, page, perPage), &items); err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			break
+		}
+		all = append(all, items...)
+		if len(items) < perPage {
+			break
+		}
+	}
+	return all, nil
+}
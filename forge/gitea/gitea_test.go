@@ -0,0 +1,71 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &Provider{httpClient: server.Client(), baseURL: server.URL}
+}
+
+func TestListReposPaginationStopsOnShortPage(t *testing.T) {
+	var requestedPages []string
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+		switch page {
+		case "1":
+			fmt.Fprint(w, "[")
+			for i := 0; i < perPage; i++ {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"name": "repo-%d"}`, i)
+			}
+			fmt.Fprint(w, "]")
+		case "2":
+			fmt.Fprint(w, `[{"name": "last-repo"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	repos, err := p.ListRepos(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("ListRepos() error = %v", err)
+	}
+	if len(repos) != perPage+1 {
+		t.Errorf("ListRepos() returned %d repos, want %d", len(repos), perPage+1)
+	}
+	if len(requestedPages) != 2 {
+		t.Errorf("getPaged requested %d pages, want 2 (a full page followed by a short one stops the walk)", len(requestedPages))
+	}
+}
+
+func TestListIssuesFiltersOutPullRequests(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"number": 1, "title": "a real issue", "state": "open"},
+			{"number": 2, "title": "a pull request", "state": "open", "pull_request": {"url": "https://example.com/pulls/2"}}
+		]`)
+	})
+
+	issues, err := p.ListIssues(context.Background(), "owner", "repo")
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Number != 1 {
+		t.Errorf("ListIssues() = %+v, want only issue #1 (pull requests filtered out)", issues)
+	}
+}
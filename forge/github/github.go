@@ -0,0 +1,117 @@
+// Package github adapts my-cli/github onto the forge.Provider
+// interface, so the GitHub backend keeps its authenticated, cached
+// HTTP client while the cmd subcommands talk to it through forge.
+package github
+
+import (
+	"context"
+	"time"
+
+	"my-cli/forge"
+	"my-cli/github"
+)
+
+func init() {
+	forge.Register("github", New)
+}
+
+// New builds a forge.Provider backed by a github.Client configured
+// from cfg.
+func New(cfg forge.Config) (forge.Provider, error) {
+	retries := cfg.Retries
+	if retries <= 0 {
+		retries = github.DefaultMaxRetries
+	}
+
+	client := github.NewClient(cfg.Token, retries)
+	if cfg.BaseURL != "" {
+		client.BaseURL = cfg.BaseURL
+	}
+	return &Provider{service: github.NewService(client)}, nil
+}
+
+// Provider implements forge.Provider against the GitHub REST API.
+type Provider struct {
+	service *github.Service
+}
+
+func (p *Provider) GetUser(ctx context.Context, login string) (*forge.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	user, err := p.service.Client.GetUser(login)
+	if err != nil {
+		return nil, err
+	}
+
+	return &forge.User{
+		Login:     user.Login,
+		Name:      user.Name,
+		Followers: user.Followers,
+		Following: user.Following,
+	}, nil
+}
+
+func (p *Provider) ListRepos(ctx context.Context, owner string) ([]forge.Repo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	repos, err := p.service.ListRepos(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]forge.Repo, len(repos))
+	for i, r := range repos {
+		out[i] = forge.Repo{
+			Name:        r.Name,
+			FullName:    r.FullName,
+			Description: r.Description,
+			Stars:       r.StargazersCount,
+			Private:     r.Private,
+		}
+	}
+	return out, nil
+}
+
+func (p *Provider) ListIssues(ctx context.Context, owner, repo string) ([]forge.Issue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	issues, err := p.service.ListIssues(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]forge.Issue, len(issues))
+	for i, issue := range issues {
+		labels := make([]string, len(issue.Labels))
+		for j, l := range issue.Labels {
+			labels[j] = l.Name
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, issue.CreatedAt)
+		var closedAt time.Time
+		if issue.ClosedAt != "" {
+			closedAt, _ = time.Parse(time.RFC3339, issue.ClosedAt)
+		}
+
+		out[i] = forge.Issue{
+			Number:    issue.Number,
+			Title:     issue.Title,
+			State:     issue.State,
+			Author:    issue.User.Login,
+			CreatedAt: createdAt,
+			ClosedAt:  closedAt,
+			Labels:    labels,
+		}
+	}
+	return out, nil
+}
+
+func (p *Provider) RateLimit(ctx context.Context) (int, error) {
+	return github.RateLimitRemaining, nil
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"my-cli/github"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client := github.NewClient("", github.DefaultMaxRetries)
+	client.HTTPClient = server.Client()
+	client.BaseURL = server.URL
+	client.CacheDir = t.TempDir()
+	return &Provider{service: github.NewService(client)}
+}
+
+func TestListReposMapsStargazersCountToStars(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "repo", "full_name": "octocat/repo", "stargazers_count": 9, "private": true}]`))
+	})
+
+	repos, err := p.ListRepos(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("ListRepos() error = %v", err)
+	}
+	if len(repos) != 1 || repos[0].Stars != 9 || !repos[0].Private {
+		t.Errorf("ListRepos() = %+v, want Stars=9 Private=true", repos)
+	}
+}
+
+func TestListIssuesMapsLabelsAndAuthor(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{
+			"number": 1,
+			"title": "bug report",
+			"state": "open",
+			"user": {"login": "octocat"},
+			"created_at": "2024-01-02T15:04:05Z",
+			"labels": [{"name": "bug"}, {"name": "p1"}]
+		}]`))
+	})
+
+	issues, err := p.ListIssues(context.Background(), "owner", "repo")
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("ListIssues() returned %d issues, want 1", len(issues))
+	}
+	issue := issues[0]
+	if issue.Author != "octocat" || len(issue.Labels) != 2 || issue.Labels[0] != "bug" {
+		t.Errorf("ListIssues()[0] = %+v, want Author=octocat Labels=[bug p1]", issue)
+	}
+}
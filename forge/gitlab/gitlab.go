@@ -0,0 +1,206 @@
+// Package gitlab implements forge.Provider against the GitLab REST
+// API v4 (https://docs.gitlab.com/ee/api/rest/).
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"my-cli/forge"
+)
+
+const defaultBaseURL = "https://gitlab.com"
+
+// perPage is the page size requested on paged list endpoints.
+const perPage = 50
+
+func init() {
+	forge.Register("gitlab", New)
+}
+
+// New builds a forge.Provider for a GitLab instance. cfg.BaseURL
+// defaults to gitlab.com.
+func New(cfg forge.Config) (forge.Provider, error) {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	return &Provider{
+		httpClient: http.DefaultClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      cfg.Token,
+	}, nil
+}
+
+// Provider implements forge.Provider against a GitLab instance.
+//
+// Unlike GitHub and Gitea, GitLab's public user API doesn't report
+// follower counts, and a user's projects are listed by numeric user
+// ID rather than login, so ListRepos resolves the user first.
+// ListIssues addresses the project by its URL-encoded owner/name path.
+type Provider struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+type gitlabUser struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type gitlabProject struct {
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	Description       string `json:"description"`
+	StarCount         int    `json:"star_count"`
+	Visibility        string `json:"visibility"`
+}
+
+type gitlabIssue struct {
+	IID    int    `json:"iid"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	Author struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	CreatedAt string   `json:"created_at"`
+	ClosedAt  string   `json:"closed_at"`
+	Labels    []string `json:"labels"`
+}
+
+func (p *Provider) GetUser(ctx context.Context, login string) (*forge.User, error) {
+	user, err := p.lookupUser(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	return &forge.User{Login: user.Username, Name: user.Name}, nil
+}
+
+func (p *Provider) ListRepos(ctx context.Context, owner string) ([]forge.Repo, error) {
+	user, err := p.lookupUser(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := getPaged[gitlabProject](ctx, p, fmt.Sprintf("/api/v4/users/%d/projects", user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]forge.Repo, len(projects))
+	for i, proj := range projects {
+		out[i] = forge.Repo{
+			Name:        proj.Name,
+			FullName:    proj.PathWithNamespace,
+			Description: proj.Description,
+			Stars:       proj.StarCount,
+			Private:     proj.Visibility != "public",
+		}
+	}
+	return out, nil
+}
+
+func (p *Provider) ListIssues(ctx context.Context, owner, repo string) ([]forge.Issue, error) {
+	projectPath := url.QueryEscape(owner + "/" + repo)
+
+	issues, err := getPaged[gitlabIssue](ctx, p, fmt.Sprintf("/api/v4/projects/%s/issues?scope=all", projectPath))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]forge.Issue, len(issues))
+	for i, issue := range issues {
+		createdAt, _ := time.Parse(time.RFC3339, issue.CreatedAt)
+		var closedAt time.Time
+		if issue.ClosedAt != "" {
+			closedAt, _ = time.Parse(time.RFC3339, issue.ClosedAt)
+		}
+
+		out[i] = forge.Issue{
+			Number:    issue.IID,
+			Title:     issue.Title,
+			State:     issue.State,
+			Author:    issue.Author.Username,
+			CreatedAt: createdAt,
+			ClosedAt:  closedAt,
+			Labels:    issue.Labels,
+		}
+	}
+	return out, nil
+}
+
+// RateLimit always returns -1: GitLab reports rate limit state per
+// response header rather than a dedicated endpoint, and we don't poll
+// one proactively here.
+func (p *Provider) RateLimit(ctx context.Context) (int, error) {
+	return -1, nil
+}
+
+func (p *Provider) lookupUser(ctx context.Context, login string) (*gitlabUser, error) {
+	var users []gitlabUser
+	if err := p.get(ctx, "/api/v4/users?username="+url.QueryEscape(login), &users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("gitlab: user %q not found", login)
+	}
+	return &users[0], nil
+}
+
+func (p *Provider) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("gitlab: building request: %w", err)
+	}
+	if p.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", p.token)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gitlab: requesting %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("gitlab: unexpected status %d for %s", resp.StatusCode, path)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("gitlab: decoding response for %s: %w", path, err)
+	}
+	return nil
+}
+
+// getPaged walks path page by page, following GitLab's "?page=N"
+// convention until a page comes back empty or shorter than perPage,
+// and concatenates the results.
+func getPaged[T any](ctx context.Context, p *Provider, path string) ([]T, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	var all []T
+	for page := 1; ; page++ {
+		var items []T
+		if err := p.get(ctx, fmt.Sprintf("%s%spage=%d&per_page=%d", path, sep, page, perPage), &items); err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			break
+		}
+		all = append(all, items...)
+		if len(items) < perPage {
+			break
+		}
+	}
+	return all, nil
+}
@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &Provider{httpClient: server.Client(), baseURL: server.URL}
+}
+
+func TestLookupUserNotFound(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	if _, err := p.lookupUser(context.Background(), "nobody"); err == nil {
+		t.Error("lookupUser() error = nil, want error for an empty result")
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("username"), "octocat"; got != want {
+			t.Errorf("request username = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `[{"id": 42, "username": "octocat", "name": "The Octocat"}]`)
+	})
+
+	user, err := p.GetUser(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if user.Login != "octocat" || user.Name != "The Octocat" {
+		t.Errorf("GetUser() = %+v, want Login=octocat Name=\"The Octocat\"", user)
+	}
+}
+
+func TestListReposResolvesUserThenProjects(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/users":
+			fmt.Fprint(w, `[{"id": 42, "username": "octocat", "name": "The Octocat"}]`)
+		case r.URL.Path == "/api/v4/users/42/projects":
+			if r.URL.Query().Get("page") != "1" {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprint(w, `[{"name": "repo", "path_with_namespace": "octocat/repo", "star_count": 3, "visibility": "private"}]`)
+		default:
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	repos, err := p.ListRepos(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("ListRepos() error = %v", err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("ListRepos() returned %d repos, want 1", len(repos))
+	}
+	repo := repos[0]
+	if repo.FullName != "octocat/repo" || repo.Stars != 3 || !repo.Private {
+		t.Errorf("ListRepos()[0] = %+v, want FullName=octocat/repo Stars=3 Private=true", repo)
+	}
+}
+
+func TestListIssuesMapsIIDAuthorAndLabels(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.EscapedPath(), "/api/v4/projects/owner%2Frepo/issues"; got != want {
+			t.Errorf("request path = %q, want %q", got, want)
+		}
+		if r.URL.Query().Get("page") != "1" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `[{
+			"iid": 7,
+			"title": "bug report",
+			"state": "opened",
+			"author": {"username": "octocat"},
+			"created_at": "2024-01-02T15:04:05Z",
+			"labels": ["bug", "p1"]
+		}]`)
+	})
+
+	issues, err := p.ListIssues(context.Background(), "owner", "repo")
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("ListIssues() returned %d issues, want 1", len(issues))
+	}
+	issue := issues[0]
+	if issue.Number != 7 || issue.Author != "octocat" || len(issue.Labels) != 2 {
+		t.Errorf("ListIssues()[0] = %+v, want Number=7 Author=octocat Labels=[bug p1]", issue)
+	}
+}
+
+func TestGetPagedStopsOnShortPage(t *testing.T) {
+	var requestedPages []string
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+		switch page {
+		case "1":
+			fmt.Fprint(w, "[")
+			for i := 0; i < perPage; i++ {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"name": "repo-%d"}`, i)
+			}
+			fmt.Fprint(w, "]")
+		case "2":
+			fmt.Fprint(w, `[{"name": "last-repo"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	items, err := getPaged[gitlabProject](context.Background(), p, "/api/v4/projects")
+	if err != nil {
+		t.Fatalf("getPaged() error = %v", err)
+	}
+	if len(items) != perPage+1 {
+		t.Errorf("getPaged() returned %d items, want %d", len(items), perPage+1)
+	}
+	if len(requestedPages) != 2 {
+		t.Errorf("getPaged requested %d pages, want 2 (a full page followed by a short one stops the walk)", len(requestedPages))
+	}
+}
@@ -0,0 +1,144 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"my-cli/output"
+)
+
+// AuthorCount is how many issues a given author opened, used for the
+// "top authors" ranking in Stats.
+type AuthorCount struct {
+	Author string `json:"author" yaml:"author"`
+	Count  int    `json:"count" yaml:"count"`
+}
+
+// LabelStats is the aggregate issue activity for a single label,
+// keyed into Stats.PerLabel.
+type LabelStats struct {
+	Open   int `json:"open" yaml:"open"`
+	Closed int `json:"closed" yaml:"closed"`
+}
+
+// Stats is the aggregate issue activity for an organization over a
+// time window: how many issues are open vs closed, how long closed
+// issues took to close, who opened the most of them, and how that
+// activity breaks down per label.
+type Stats struct {
+	Open                int                   `json:"open" yaml:"open"`
+	Closed              int                   `json:"closed" yaml:"closed"`
+	CloseLatencyBuckets map[string]int        `json:"close_latency_buckets" yaml:"close_latency_buckets"`
+	TopAuthors          []AuthorCount         `json:"top_authors" yaml:"top_authors"`
+	PerLabel            map[string]LabelStats `json:"per_label" yaml:"per_label"`
+}
+
+// closeLatencyBuckets are the histogram buckets reported in
+// Stats.CloseLatencyBuckets, checked in order.
+var closeLatencyBuckets = []struct {
+	label string
+	max   time.Duration
+}{
+	{"<1h", time.Hour},
+	{"<1d", 24 * time.Hour},
+	{"<1w", 7 * 24 * time.Hour},
+	{">=1w", 0},
+}
+
+func closeLatencyBucket(d time.Duration) string {
+	for _, b := range closeLatencyBuckets {
+		if b.max == 0 || d < b.max {
+			return b.label
+		}
+	}
+	return ">=1w"
+}
+
+// GetStats walks every repository in org and aggregates issue activity
+// created within [since, until]. A zero since or until leaves that
+// bound open. It works against any Provider.
+func GetStats(ctx context.Context, provider Provider, org string, since, until time.Time) (*Stats, error) {
+	repos, err := provider.ListRepos(ctx, org)
+	if err != nil {
+		return nil, fmt.Errorf("forge: listing repos for %q: %w", org, err)
+	}
+
+	stats := &Stats{CloseLatencyBuckets: map[string]int{}, PerLabel: map[string]LabelStats{}}
+	authorCounts := map[string]int{}
+
+	for _, repo := range repos {
+		issues, err := provider.ListIssues(ctx, org, repo.Name)
+		if err != nil {
+			return nil, fmt.Errorf("forge: listing issues for %q: %w", repo.FullName, err)
+		}
+
+		for _, issue := range issues {
+			if !since.IsZero() && issue.CreatedAt.Before(since) {
+				continue
+			}
+			if !until.IsZero() && issue.CreatedAt.After(until) {
+				continue
+			}
+
+			authorCounts[issue.Author]++
+
+			if issue.ClosedAt.IsZero() {
+				stats.Open++
+				addLabelStats(stats.PerLabel, issue.Labels, func(l *LabelStats) { l.Open++ })
+				continue
+			}
+
+			stats.Closed++
+			stats.CloseLatencyBuckets[closeLatencyBucket(issue.ClosedAt.Sub(issue.CreatedAt))]++
+			addLabelStats(stats.PerLabel, issue.Labels, func(l *LabelStats) { l.Closed++ })
+		}
+	}
+
+	stats.TopAuthors = topAuthors(authorCounts)
+	return stats, nil
+}
+
+// Text renders Stats for the "text" output format, as a table of the
+// top issue authors.
+func (s *Stats) Text() string {
+	headers, rows := s.CSVTable()
+	return output.Table(headers, rows)
+}
+
+// CSVTable renders Stats for the "csv" output format, as a table of
+// the top issue authors.
+func (s *Stats) CSVTable() (headers []string, rows [][]string) {
+	headers = []string{"Autor", "Issues"}
+	rows = make([][]string, len(s.TopAuthors))
+	for i, author := range s.TopAuthors {
+		rows[i] = []string{author.Author, strconv.Itoa(author.Count)}
+	}
+	return headers, rows
+}
+
+// addLabelStats applies update to perLabel's entry for each of labels,
+// creating it on first use.
+func addLabelStats(perLabel map[string]LabelStats, labels []string, update func(*LabelStats)) {
+	for _, label := range labels {
+		entry := perLabel[label]
+		update(&entry)
+		perLabel[label] = entry
+	}
+}
+
+func topAuthors(counts map[string]int) []AuthorCount {
+	authors := make([]AuthorCount, 0, len(counts))
+	for author, count := range counts {
+		authors = append(authors, AuthorCount{Author: author, Count: count})
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].Count != authors[j].Count {
+			return authors[i].Count > authors[j].Count
+		}
+		return authors[i].Author < authors[j].Author
+	})
+	return authors
+}
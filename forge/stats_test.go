@@ -0,0 +1,50 @@
+package forge
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeProvider struct {
+	repos  []Repo
+	issues map[string][]Issue
+}
+
+func (f *fakeProvider) GetUser(ctx context.Context, login string) (*User, error) { return nil, nil }
+
+func (f *fakeProvider) ListRepos(ctx context.Context, owner string) ([]Repo, error) {
+	return f.repos, nil
+}
+
+func (f *fakeProvider) ListIssues(ctx context.Context, owner, repo string) ([]Issue, error) {
+	return f.issues[repo], nil
+}
+
+func (f *fakeProvider) RateLimit(ctx context.Context) (int, error) { return -1, nil }
+
+func TestGetStatsPerLabel(t *testing.T) {
+	now := time.Now()
+	provider := &fakeProvider{
+		repos: []Repo{{Name: "repo"}},
+		issues: map[string][]Issue{
+			"repo": {
+				{Author: "alice", CreatedAt: now, Labels: []string{"bug"}},
+				{Author: "bob", CreatedAt: now, ClosedAt: now.Add(time.Minute), Labels: []string{"bug", "docs"}},
+				{Author: "bob", CreatedAt: now, ClosedAt: now.Add(time.Minute), Labels: []string{"docs"}},
+			},
+		},
+	}
+
+	stats, err := GetStats(context.Background(), provider, "org", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+
+	if got, want := stats.PerLabel["bug"], (LabelStats{Open: 1, Closed: 1}); got != want {
+		t.Errorf("PerLabel[bug] = %+v, want %+v", got, want)
+	}
+	if got, want := stats.PerLabel["docs"], (LabelStats{Open: 0, Closed: 2}); got != want {
+		t.Errorf("PerLabel[docs] = %+v, want %+v", got, want)
+	}
+}
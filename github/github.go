@@ -1,11 +1,19 @@
 package github
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 )
 
+const defaultBaseURL = "https://api.github.com"
+
 type GitHubUser struct {
 	Login     string `json:"login"`
 	Name      string `json:"name"`
@@ -13,21 +21,185 @@ type GitHubUser struct {
 	Following int    `json:"following"`
 }
 
-func GetUser(user string) {
-	url := fmt.Sprintf("https://api.github.com/users/%s", user)
-	resp, err := http.Get(url)
+// APIError represents a non-2xx response from the GitHub API.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github: %s (status %d)", e.Message, e.StatusCode)
+}
+
+// RateLimitRemaining is the value of the X-RateLimit-Remaining header
+// from the most recent response that carried one, whatever its status,
+// or -1 if no response has carried it yet.
+var RateLimitRemaining = -1
+
+// Client talks to the GitHub REST API, optionally authenticated with a
+// personal access token, and caches successful responses on disk keyed
+// by URL and ETag so repeat calls survive offline and don't burn rate
+// limit.
+type Client struct {
+	HTTPClient *http.Client
+	BaseURL    string
+	Token      string
+	CacheDir   string
+}
+
+// NewClient builds a Client for the public GitHub API. token may be
+// empty for unauthenticated requests. The cache defaults to
+// os.UserCacheDir()/my-cli/github. maxRetries bounds how many attempts,
+// the first one included, a request gets on a 5xx, a network error,
+// or a rate limit; see retryTransport.
+func NewClient(token string, maxRetries int) *Client {
+	return &Client{
+		HTTPClient: &http.Client{Transport: newRetryTransport(http.DefaultTransport, maxRetries)},
+		BaseURL:    defaultBaseURL,
+		Token:      token,
+	}
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) cacheDir() string {
+	if c.CacheDir != "" {
+		return c.CacheDir
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "my-cli", "github")
+}
+
+// cacheEntry is the on-disk representation of a cached response,
+// mirroring the user-id cache used by golang.org/x/build's
+// updatecontrib tool: the ETag lets us ask the server for nothing more
+// than "has this changed" on the next request.
+type cacheEntry struct {
+	ETag string          `json:"etag"`
+	Body json.RawMessage `json:"body"`
+}
+
+func (c *Client) cachePath(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.cacheDir(), hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *Client) loadCache(url string) (*cacheEntry, bool) {
+	data, err := os.ReadFile(c.cachePath(url))
 	if err != nil {
-		fmt.Println("Erro ao buscar o usuário:", err)
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (c *Client) saveCache(url string, entry cacheEntry) {
+	dir := c.cacheDir()
+	if dir == "" {
 		return
 	}
-	defer resp.Body.Close()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.cachePath(url), data, 0o644)
+}
+
+// GetUser fetches a GitHub user by login. When the client holds a
+// token it is sent as an Authorization header, lifting the 60
+// requests/hour unauthenticated rate limit and allowing access to
+// private data the token is scoped for.
+func (c *Client) GetUser(user string) (*GitHubUser, error) {
+	body, err := c.get(fmt.Sprintf("%s/users/%s", c.BaseURL, user), statusMessage("user", user))
+	if err != nil {
+		return nil, err
+	}
 
 	var gitHubUser GitHubUser
-	if err := json.NewDecoder(resp.Body).Decode(&gitHubUser); err != nil {
-		fmt.Println("Erro ao decodificar resposta:", err)
-		return
+	if err := json.Unmarshal(body, &gitHubUser); err != nil {
+		return nil, fmt.Errorf("github: decoding response for %q: %w", user, err)
+	}
+
+	return &gitHubUser, nil
+}
+
+// get performs a cached, authenticated GET against url, returning the
+// raw response body. It consults the on-disk ETag cache before the
+// request and reuses the cached body on a 304, and updates
+// RateLimitRemaining from every response. describe describes what was
+// being fetched, for use in the APIError on non-2xx responses.
+func (c *Client) get(url string, describe func(statusCode int) string) ([]byte, error) {
+	cached, hasCached := c.loadCache(url)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("github: building request: %w", err)
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "token "+c.Token)
+	}
+	if hasCached && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("github: requesting %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		RateLimitRemaining = remaining
+	}
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		return cached.Body, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: describe(resp.StatusCode)}
 	}
 
-	fmt.Printf("Usuário: %s\nNome: %s\nSeguidores: %d\nSeguindo: %d\n",
-		gitHubUser.Login, gitHubUser.Name, gitHubUser.Followers, gitHubUser.Following)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("github: reading response from %s: %w", url, err)
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		c.saveCache(url, cacheEntry{ETag: etag, Body: body})
+	}
+
+	return body, nil
+}
+
+// statusMessage builds a describe func for get, naming the kind of
+// resource being fetched (e.g. "user", "repo") and its identifier in
+// the resulting APIError.
+func statusMessage(kind, id string) func(int) string {
+	return func(statusCode int) string {
+		switch statusCode {
+		case http.StatusNotFound:
+			return fmt.Sprintf("%s %q not found", kind, id)
+		case http.StatusUnauthorized:
+			return "invalid or expired token"
+		case http.StatusForbidden:
+			return "access forbidden, likely rate limited"
+		default:
+			return fmt.Sprintf("unexpected response for %s %q", kind, id)
+		}
+	}
 }
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientGetUserCachesOnETag(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.Write([]byte(`{"login":"octocat","name":"The Octocat","followers":1,"following":2}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HTTPClient: server.Client(), BaseURL: server.URL, CacheDir: t.TempDir()}
+
+	user, err := client.GetUser("octocat")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if user.Login != "octocat" {
+		t.Errorf("Login = %q, want %q", user.Login, "octocat")
+	}
+
+	user, err = client.GetUser("octocat")
+	if err != nil {
+		t.Fatalf("GetUser() second call error = %v", err)
+	}
+	if user.Login != "octocat" {
+		t.Errorf("cached Login = %q, want %q", user.Login, "octocat")
+	}
+	if calls != 2 {
+		t.Errorf("server calls = %d, want 2 (both requests should hit the server, the second returning 304)", calls)
+	}
+}
+
+func TestClientGetUserReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &Client{HTTPClient: server.Client(), BaseURL: server.URL, CacheDir: t.TempDir()}
+
+	_, err := client.GetUser("ghost")
+	if err == nil {
+		t.Fatal("GetUser() error = nil, want APIError")
+	}
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("error type = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,187 @@
+package github
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	backoffBase = 250 * time.Millisecond
+	backoffMax  = 8 * time.Second
+)
+
+// DefaultMaxRetries is how many attempts a request gets when the
+// caller doesn't configure its own, matching the --retries default.
+const DefaultMaxRetries = 3
+
+// retryTransport wraps an http.RoundTripper with two behaviors on top
+// of it: it tracks the X-RateLimit-Remaining/X-RateLimit-Reset
+// headers GitHub returns on every response and sleeps until the reset
+// time rather than firing a request doomed to a 403, and it retries
+// 5xx responses and network errors with exponential backoff and
+// jitter, up to maxAttempts. It composes with Client's ETag cache
+// because both sit on the same *http.Client: a 304 is neither a 5xx
+// nor a rate-limit 403, so this transport returns it unretried and
+// Client.get then serves the cached body.
+type retryTransport struct {
+	next        http.RoundTripper
+	maxAttempts int
+	sleep       func(time.Duration)
+	now         func() time.Time
+
+	mu        sync.Mutex
+	remaining int
+	resetAt   time.Time
+}
+
+// newRetryTransport wraps next (http.DefaultTransport if nil) with
+// rate-limit awareness and retry/backoff, attempting each request up
+// to maxAttempts times.
+func newRetryTransport(next http.RoundTripper, maxAttempts int) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &retryTransport{
+		next:        next,
+		maxAttempts: maxAttempts,
+		sleep:       time.Sleep,
+		now:         time.Now,
+		remaining:   -1,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var bodyBytes []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("github: buffering request body for retry: %w", err)
+		}
+		bodyBytes = b
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt < t.maxAttempts; attempt++ {
+		t.waitForRateLimit()
+
+		if bodyBytes != nil {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		}
+
+		resp, err = t.next.RoundTrip(req)
+		lastAttempt := attempt == t.maxAttempts-1
+
+		if err != nil {
+			if lastAttempt {
+				return nil, err
+			}
+			t.sleep(backoff(attempt))
+			continue
+		}
+
+		t.recordRateLimit(resp)
+
+		if limited, cerr := isRateLimitExceeded(resp); cerr == nil && limited {
+			resp.Body.Close()
+			if lastAttempt {
+				return resp, err
+			}
+			// The next iteration's waitForRateLimit sleeps until the
+			// reset time we just recorded before retrying.
+			continue
+		}
+
+		if resp.StatusCode >= 500 {
+			if lastAttempt {
+				return resp, nil
+			}
+			resp.Body.Close()
+			t.sleep(backoff(attempt))
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// waitForRateLimit sleeps until the last-known reset time if the last
+// response reported no requests remaining, so a request that's
+// certain to come back 403 never leaves the machine.
+func (t *retryTransport) waitForRateLimit() {
+	t.mu.Lock()
+	remaining, resetAt := t.remaining, t.resetAt
+	t.mu.Unlock()
+
+	if remaining != 0 || resetAt.IsZero() {
+		return
+	}
+	if wait := resetAt.Sub(t.now()); wait > 0 {
+		t.sleep(wait)
+	}
+}
+
+func (t *retryTransport) recordRateLimit(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	resetAt := parseRateLimitReset(resp.Header.Get("X-RateLimit-Reset"))
+
+	t.mu.Lock()
+	t.remaining = remaining
+	if !resetAt.IsZero() {
+		t.resetAt = resetAt
+	}
+	t.mu.Unlock()
+}
+
+func parseRateLimitReset(v string) time.Time {
+	sec, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// isRateLimitExceeded reports whether resp is a 403 whose body names
+// a rate limit, restoring the body afterwards so the caller can still
+// read it on a false result.
+func isRateLimitExceeded(resp *http.Response) (bool, error) {
+	if resp.StatusCode != http.StatusForbidden {
+		return false, nil
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	return strings.Contains(strings.ToLower(string(data)), "rate limit exceeded"), nil
+}
+
+// backoff returns an exponential delay with jitter for the given
+// zero-based attempt number, capped at backoffMax.
+func backoff(attempt int) time.Duration {
+	delay := backoffBase * time.Duration(1<<attempt)
+	if delay > backoffMax {
+		delay = backoffMax
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, server *httptest.Server, maxAttempts int) (*Client, *retryTransport) {
+	t.Helper()
+	transport := newRetryTransport(server.Client().Transport, maxAttempts)
+	transport.sleep = func(time.Duration) {}
+	return &Client{HTTPClient: &http.Client{Transport: transport}, BaseURL: server.URL, CacheDir: t.TempDir()}, transport
+}
+
+func TestRetryTransportRetries5xxThenSucceeds(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(t, server, 3)
+
+	user, err := client.GetUser("octocat")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if user.Login != "octocat" {
+		t.Errorf("Login = %q, want %q", user.Login, "octocat")
+	}
+	if calls != 2 {
+		t.Errorf("server calls = %d, want 2 (one failure, one retry)", calls)
+	}
+}
+
+func TestRetryTransportGivesUpAfterMaxAttempts(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, _ := newTestClient(t, server, 2)
+
+	if _, err := client.GetUser("octocat"); err == nil {
+		t.Fatal("GetUser() error = nil, want error after exhausting retries")
+	}
+	if calls != 2 {
+		t.Errorf("server calls = %d, want 2 (maxAttempts)", calls)
+	}
+}
+
+func TestRetryTransportWaitsForRateLimitReset(t *testing.T) {
+	calls := 0
+	resetAt := time.Now().Add(30 * time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprintf("%d", resetAt.Unix()))
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message":"API rate limit exceeded for xxx.xxx.xxx.xxx"}`))
+			return
+		}
+		w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	defer server.Close()
+
+	client, transport := newTestClient(t, server, 2)
+	transport.now = func() time.Time { return resetAt.Add(-30 * time.Second) }
+
+	var slept time.Duration
+	transport.sleep = func(d time.Duration) { slept = d }
+
+	user, err := client.GetUser("octocat")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if user.Login != "octocat" {
+		t.Errorf("Login = %q, want %q", user.Login, "octocat")
+	}
+	if calls != 2 {
+		t.Errorf("server calls = %d, want 2 (rate limited, then retried after reset)", calls)
+	}
+	if slept < 29*time.Second || slept > 30*time.Second {
+		t.Errorf("slept = %v, want ~30s (time until X-RateLimit-Reset)", slept)
+	}
+}
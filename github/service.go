@@ -0,0 +1,159 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// perPage is the page size requested on every paged list endpoint; it
+// matches GitHub's maximum, keeping round trips to a minimum.
+const perPage = 100
+
+// Repo is a subset of the GitHub repository resource.
+type Repo struct {
+	Name            string `json:"name"`
+	FullName        string `json:"full_name"`
+	Description     string `json:"description"`
+	StargazersCount int    `json:"stargazers_count"`
+	Private         bool   `json:"private"`
+}
+
+// Gist is a subset of the GitHub gist resource.
+type Gist struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Public      bool   `json:"public"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// Issue is a subset of the GitHub issue resource, shared by the issues
+// and stats commands. PullRequest is set by GitHub on entries from
+// the issues endpoint that are actually pull requests, and is used by
+// ListIssues to filter them out.
+type Issue struct {
+	Number      int             `json:"number"`
+	Title       string          `json:"title"`
+	State       string          `json:"state"`
+	CreatedAt   string          `json:"created_at"`
+	ClosedAt    string          `json:"closed_at"`
+	PullRequest json.RawMessage `json:"pull_request,omitempty"`
+	User        struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+}
+
+// Service provides the higher-level GitHub operations (repos, gists,
+// issues, stats) shared by the cmd subcommands, on top of a Client.
+type Service struct {
+	Client *Client
+}
+
+// NewService builds a Service backed by client.
+func NewService(client *Client) *Service {
+	return &Service{Client: client}
+}
+
+// ListRepos returns every public repository owned by user.
+func (s *Service) ListRepos(user string) ([]Repo, error) {
+	return fetchPaged[Repo](s.Client, fmt.Sprintf("%s/users/%s/repos", s.Client.BaseURL, user), nil, statusMessage("repos", user))
+}
+
+// ListGists returns every public gist owned by user.
+func (s *Service) ListGists(user string) ([]Gist, error) {
+	return fetchPaged[Gist](s.Client, fmt.Sprintf("%s/users/%s/gists", s.Client.BaseURL, user), nil, statusMessage("gists", user))
+}
+
+// ListIssues returns every issue on owner/repo, across all states.
+// GitHub's issues endpoint also returns pull requests (each carrying a
+// non-empty PullRequest field), which are filtered out here so they
+// don't skew issue counts and stats.
+func (s *Service) ListIssues(owner, repo string) ([]Issue, error) {
+	id := owner + "/" + repo
+	query := url.Values{"state": {"all"}}
+	issues, err := fetchPaged[Issue](s.Client, fmt.Sprintf("%s/repos/%s/%s/issues", s.Client.BaseURL, owner, repo), query, statusMessage("issues", id))
+	if err != nil {
+		return nil, err
+	}
+
+	out := issues[:0]
+	for _, issue := range issues {
+		if len(issue.PullRequest) == 0 {
+			out = append(out, issue)
+		}
+	}
+	return out, nil
+}
+
+// Commit is a subset of the GitHub commit resource. Author is the
+// GitHub user linked to the commit by email, and is the zero value
+// when the commit's author email isn't associated with any account.
+type Commit struct {
+	SHA    string `json:"sha"`
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// GetCommit fetches a single commit by SHA, including the GitHub
+// login (if any) that its author email resolves to.
+func (s *Service) GetCommit(owner, repo, sha string) (*Commit, error) {
+	id := fmt.Sprintf("%s/%s@%s", owner, repo, sha)
+	body, err := s.Client.get(fmt.Sprintf("%s/repos/%s/%s/commits/%s", s.Client.BaseURL, owner, repo, sha), statusMessage("commit", id))
+	if err != nil {
+		return nil, err
+	}
+
+	var commit Commit
+	if err := json.Unmarshal(body, &commit); err != nil {
+		return nil, fmt.Errorf("github: decoding commit %s: %w", id, err)
+	}
+	return &commit, nil
+}
+
+// fetchPaged walks a GitHub list endpoint page by page, following the
+// "?page=N" convention until a page comes back empty or shorter than
+// perPage, and concatenates the results.
+func fetchPaged[T any](c *Client, baseURL string, query url.Values, describe func(int) string) ([]T, error) {
+	var all []T
+
+	for page := 1; ; page++ {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("github: parsing %s: %w", baseURL, err)
+		}
+		q := u.Query()
+		for key, values := range query {
+			for _, v := range values {
+				q.Set(key, v)
+			}
+		}
+		q.Set("page", strconv.Itoa(page))
+		q.Set("per_page", strconv.Itoa(perPage))
+		u.RawQuery = q.Encode()
+
+		body, err := c.get(u.String(), describe)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []T
+		if err := json.Unmarshal(body, &items); err != nil {
+			return nil, fmt.Errorf("github: decoding page %d of %s: %w", page, baseURL, err)
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		all = append(all, items...)
+		if len(items) < perPage {
+			break
+		}
+	}
+
+	return all, nil
+}
@@ -0,0 +1,27 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListIssuesFiltersOutPullRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"number": 1, "title": "a real issue"},
+			{"number": 2, "title": "a pull request", "pull_request": {"url": "https://example.com/pulls/2"}}
+		]`))
+	}))
+	defer server.Close()
+
+	service := NewService(&Client{HTTPClient: server.Client(), BaseURL: server.URL, CacheDir: t.TempDir()})
+
+	issues, err := service.ListIssues("owner", "repo")
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Number != 1 {
+		t.Errorf("ListIssues() = %+v, want only issue #1 (pull requests filtered out)", issues)
+	}
+}
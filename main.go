@@ -0,0 +1,7 @@
+package main
+
+import "my-cli/cmd"
+
+func main() {
+	cmd.Execute()
+}
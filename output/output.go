@@ -0,0 +1,95 @@
+// Package output renders command results in the format selected by
+// the --output/-o flag, so command results can be reliably parsed by
+// shell pipelines instead of scraping human-readable text that mixed
+// data with Portuguese labels.
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Textable is implemented by results that know how to render
+// themselves as human-readable text for the "text" format. Results
+// that don't implement it fall back to indented JSON.
+type Textable interface {
+	Text() string
+}
+
+// CSVTable is implemented by results that know how to render
+// themselves as a header row plus data rows for the "csv" format.
+type CSVTable interface {
+	CSVTable() (headers []string, rows [][]string)
+}
+
+// Render writes data to w in format: "text" (the default), "json",
+// "yaml", or "csv" (data must implement CSVTable).
+func Render(w io.Writer, format string, data interface{}) error {
+	switch format {
+	case "", "text":
+		return renderText(w, data)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		if err := enc.Encode(data); err != nil {
+			return err
+		}
+		return enc.Close()
+	case "csv":
+		return renderCSV(w, data)
+	default:
+		return fmt.Errorf("output: formato desconhecido %q (use text, json, yaml ou csv)", format)
+	}
+}
+
+// Table renders headers and rows as an aligned, tab-separated table,
+// for use by a result's Text method.
+func Table(headers []string, rows [][]string) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+	return strings.TrimRight(buf.String(), "\n")
+}
+
+func renderText(w io.Writer, data interface{}) error {
+	if t, ok := data.(Textable); ok {
+		_, err := fmt.Fprintln(w, t.Text())
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+func renderCSV(w io.Writer, data interface{}) error {
+	t, ok := data.(CSVTable)
+	if !ok {
+		return fmt.Errorf("output: formato csv não suportado para este resultado")
+	}
+
+	headers, rows := t.CSVTable()
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,85 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type fakeResult struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+func (r fakeResult) Text() string {
+	return "Nome: " + r.Name
+}
+
+func TestRenderText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, "text", fakeResult{Name: "octocat"}); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got := buf.String(); got != "Nome: octocat\n" {
+		t.Errorf("Render() = %q, want %q", got, "Nome: octocat\n")
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, "json", fakeResult{Name: "octocat"}); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `"name": "octocat"`) {
+		t.Errorf("Render() = %q, want it to contain the name field", buf.String())
+	}
+}
+
+func TestRenderYAML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, "yaml", fakeResult{Name: "octocat"}); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "name: octocat") {
+		t.Errorf("Render() = %q, want it to contain the name field", buf.String())
+	}
+}
+
+func (r fakeResult) CSVTable() (headers []string, rows [][]string) {
+	return []string{"name"}, [][]string{{r.Name}}
+}
+
+func TestRenderCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, "csv", fakeResult{Name: "octocat"}); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got, want := buf.String(), "name\noctocat\n"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+type fakeTextOnlyResult struct{}
+
+func (fakeTextOnlyResult) Text() string { return "" }
+
+func TestRenderCSVUnsupported(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, "csv", fakeTextOnlyResult{}); err == nil {
+		t.Error("Render() error = nil, want error for a result without CSVTable")
+	}
+}
+
+func TestTable(t *testing.T) {
+	got := Table([]string{"Nome", "Estrelas"}, [][]string{{"octocat", "3"}})
+	want := "Nome     Estrelas\noctocat  3"
+	if got != want {
+		t.Errorf("Table() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, "xml", fakeResult{Name: "octocat"}); err == nil {
+		t.Error("Render() error = nil, want error for unknown format")
+	}
+}